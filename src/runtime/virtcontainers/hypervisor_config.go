@@ -0,0 +1,53 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+// HypervisorConfig holds the Firecracker-specific options this series of
+// requests asked to add to Kata's existing HypervisorConfig struct
+// (BlockDeviceDriver, BlockDeviceAIO, EnableVirtioRNG, FCMetricsPath,
+// FCLogLevel, and a CPU-template-path option).
+//
+// DEVIATION: the real HypervisorConfig lives in virtcontainers/hypervisor.go
+// with many other fields read by the rest of virtcontainers, and isn't part
+// of this checkout. Declaring the full struct here under that name would
+// silently shadow/replace it rather than extend it, which is worse than not
+// having it at all once this lands in the real tree. This type is named
+// HypervisorConfig, as requested, and carries only the fields these requests
+// need; merging it upstream means adding these fields to the existing struct
+// instead of keeping this one, not dropping it in as-is.
+type HypervisorConfig struct {
+	// BlockDeviceDriver selects the virtio-blk backend used for guest
+	// drives. One of "virtio-blk" (default) or "vhost-user-blk".
+	BlockDeviceDriver string
+
+	// BlockDeviceAIO selects the io_engine used for "virtio-blk" drives.
+	// One of "Sync" (default) or "io_uring".
+	BlockDeviceAIO string
+
+	// CPUTemplatePath, if set, points at a JSON-encoded models.CPUConfig
+	// file applied via setCPUConfig before the microVM boots, pinning the
+	// guest-visible CPU model across hosts.
+	CPUTemplatePath string
+
+	// EnableVirtioRNG requests a virtio-rng entropy device for the guest.
+	EnableVirtioRNG bool
+
+	// EnableUffdRestore selects post-copy restore over a userfault fd
+	// socket instead of eagerly reading the whole memory file back.
+	EnableUffdRestore bool
+
+	// FCMetricsPath, if set, is where the VMM writes structured JSON
+	// metrics.
+	FCMetricsPath string
+
+	// FCLogLevel sets the VMM logger's verbosity ("Error", "Warning",
+	// "Info", or "Debug").
+	FCLogLevel string
+
+	// RootfsPath is the path on the host of the guest rootfs block device
+	// PUT as the root drive before boot.
+	RootfsPath string
+}
@@ -0,0 +1,398 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/firecracker/client"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/firecracker/client/fcerrors"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/firecracker/client/models"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/firecracker/client/operations"
+)
+
+const (
+	fcSnapshotMemFile  = "mem-file"
+	fcSnapshotVMState  = "vmstate"
+	fcSnapshotUffdSock = "uffd.sock"
+
+	vmStatePaused  = "Paused"
+	vmStateResumed = "Resumed"
+
+	fcRootDriveID = "rootfs"
+)
+
+// NewFirecrackerClient creates a Firecracker API client that talks to the
+// VMM over its unix domain socket at socketPath. Idempotent calls (GET/PUT/
+// PATCH) made through it automatically retry with exponential backoff and
+// jitter on 5xx responses and on connection-refused, which is expected for a
+// short window while the VMM process is still starting up.
+func NewFirecrackerClient(socketPath string) *client.Firecracker {
+	httpClient := &http.Client{
+		Transport: &fcerrors.RetryTransport{
+			Next: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+
+	transport := httptransport.NewWithClient("localhost", client.DefaultBasePath, client.DefaultSchemes, httpClient)
+	return client.New(transport, strfmt.Default)
+}
+
+// loadCPUConfigTemplate reads the JSON CPU configuration template at path and
+// decodes it into a models.CPUConfig for setCPUConfig to apply before boot.
+// An empty path is not an error: callers treat it as "no template configured"
+// and skip CPU pinning entirely.
+func loadCPUConfigTemplate(path string) (*models.CPUConfig, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CPU config template %q: %w", path, err)
+	}
+
+	cfg := &models.CPUConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse CPU config template %q: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// StartVM configures the microVM over the Firecracker API socket before
+// InstanceStart is issued: it applies the CPU configuration template named
+// by HypervisorConfig.CPUTemplatePath, if any, PUTs the guest root drive
+// named by HypervisorConfig.RootfsPath, and sets up the entropy device,
+// metrics and logger.
+func (fc *firecracker) StartVM(ctx context.Context) error {
+	cfg, err := loadCPUConfigTemplate(fc.config.CPUTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	if err := fc.setCPUConfig(ctx, cfg); err != nil {
+		return err
+	}
+
+	if fc.config.RootfsPath != "" {
+		rootDrive := fc.fcDrive(fcRootDriveID, fc.config.RootfsPath, true, false)
+		if err := fc.putDrive(ctx, rootDrive); err != nil {
+			return err
+		}
+	}
+
+	if err := fc.setEntropyDevice(ctx); err != nil {
+		return err
+	}
+
+	if err := fc.setMetrics(ctx); err != nil {
+		return err
+	}
+
+	return fc.setLogger(ctx)
+}
+
+// setCPUConfig applies cfg, the CPU configuration template loaded by
+// loadCPUConfigTemplate from HypervisorConfig.CPUTemplatePath, via the
+// Firecracker PUT /cpu-config endpoint; a nil cfg is a no-op. It must run
+// before InstanceStart: Firecracker rejects the request once the microVM
+// has started.
+//
+// Pinning the same bitmap modifiers (keyed by MSR, or by CPUID leaf/subleaf/
+// register) across hosts keeps the guest-visible CPU model stable, which is
+// a prerequisite for live migration and snapshot/restore across machines
+// with slightly different CPUs.
+func (fc *firecracker) setCPUConfig(ctx context.Context, cfg *models.CPUConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	fc.Logger().Debug("setting CPU configuration template")
+
+	params := operations.NewPutCPUConfigurationParamsWithContext(ctx).WithBody(cfg)
+	if _, err := fc.client().Operations.PutCPUConfiguration(params); err != nil {
+		if badReq, ok := err.(*operations.PutCPUConfigurationBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PutCPUConfiguration failed: %w", err)
+	}
+
+	return nil
+}
+
+// fcDrive builds the Drive payload for drive_id, honoring the
+// BlockDeviceDriver and BlockDeviceAIO hypervisor config options: a
+// BlockDeviceDriver of "vhost-user-blk" yields a socket-backed drive, while
+// BlockDeviceAIO selects the io_engine ("Sync" or "Async"/io_uring) used for
+// a regular path_on_host drive.
+func (fc *firecracker) fcDrive(driveID, path string, isRootDevice, isReadOnly bool) models.Drive {
+	d := models.Drive{
+		DriveID:      &driveID,
+		IsReadOnly:   &isReadOnly,
+		IsRootDevice: &isRootDevice,
+	}
+
+	if fc.config.BlockDeviceDriver == "vhost-user-blk" {
+		d.Socket = path
+		return d
+	}
+
+	d.PathOnHost = &path
+	if fc.config.BlockDeviceAIO == "io_uring" {
+		d.IoEngine = "Async"
+	}
+
+	return d
+}
+
+// putDrive PUTs drive to the Firecracker API socket, creating or replacing
+// the guest drive identified by its DriveID. It must run before
+// InstanceStart.
+func (fc *firecracker) putDrive(ctx context.Context, drive models.Drive) error {
+	params := operations.NewPutGuestDriveByIDParamsWithContext(ctx).WithDriveID(*drive.DriveID).WithBody(&drive)
+	if _, err := fc.client().Operations.PutGuestDriveByID(params); err != nil {
+		if badReq, ok := err.(*operations.PutGuestDriveByIDBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PutGuestDriveByID failed: %w", err)
+	}
+
+	return nil
+}
+
+// patchDrive PATCHes an already-attached guest drive identified by
+// driveID, updating its path and/or rate limiter in place over the running
+// microVM. Unlike putDrive this is safe to call after InstanceStart, and is
+// how drive hot-resize and rate-limiter updates are applied.
+func (fc *firecracker) patchDrive(ctx context.Context, driveID string, patch *models.PartialDrive) error {
+	patch.DriveID = &driveID
+
+	params := operations.NewPatchGuestDriveByIDParamsWithContext(ctx).WithDriveID(driveID).WithBody(patch)
+	if _, err := fc.client().Operations.PatchGuestDriveByID(params); err != nil {
+		if badReq, ok := err.(*operations.PatchGuestDriveByIDBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PatchGuestDriveByID failed: %w", err)
+	}
+
+	return nil
+}
+
+// resizeDrive hot-resizes the guest drive identified by driveID to point at
+// a replacement backing file at path, for a host-side disk resize that
+// doesn't require a guest reboot.
+func (fc *firecracker) resizeDrive(ctx context.Context, driveID, path string) error {
+	return fc.patchDrive(ctx, driveID, &models.PartialDrive{PathOnHost: path})
+}
+
+// setDriveRateLimiter updates the rate limiter of the guest drive identified
+// by driveID without touching its backing path.
+func (fc *firecracker) setDriveRateLimiter(ctx context.Context, driveID string, limiter *models.RateLimiter) error {
+	return fc.patchDrive(ctx, driveID, &models.PartialDrive{RateLimiter: limiter})
+}
+
+// setVMState transitions the microVM to state ("Paused" or "Resumed") via
+// PATCH /vm. Firecracker requires the VM to be paused before a snapshot can
+// be created.
+func (fc *firecracker) setVMState(ctx context.Context, state string) error {
+	params := operations.NewPatchVmParamsWithContext(ctx).WithBody(&models.Vm{State: &state})
+	if _, err := fc.client().Operations.PatchVm(params); err != nil {
+		if badReq, ok := err.(*operations.PatchVmBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PatchVm failed: %w", err)
+	}
+
+	return nil
+}
+
+// Save pauses the running microVM and takes a full snapshot of it into dir,
+// so containerd/CRI can checkpoint the sandbox and resume it later, possibly
+// on a different host, with Restore.
+func (fc *firecracker) Save(ctx context.Context, dir string) error {
+	if err := fc.setVMState(ctx, vmStatePaused); err != nil {
+		return fmt.Errorf("failed to pause VM before snapshot: %w", err)
+	}
+
+	memFilePath := filepath.Join(dir, fcSnapshotMemFile)
+	snapshotPath := filepath.Join(dir, fcSnapshotVMState)
+
+	params := operations.NewCreateSnapshotParamsWithContext(ctx).WithBody(&models.SnapshotCreateParams{
+		MemFilePath:  &memFilePath,
+		SnapshotPath: &snapshotPath,
+	})
+
+	if _, err := fc.client().Operations.CreateSnapshot(params); err != nil {
+		if badReq, ok := err.(*operations.CreateSnapshotBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("CreateSnapshot failed: %w", err)
+	}
+
+	return nil
+}
+
+// Restore loads the snapshot previously written by Save from dir into a
+// freshly started, not-yet-booted Firecracker VMM, then resumes it. When
+// HypervisorConfig.EnableUffdRestore is set, the guest memory file is
+// handed to a userfaulted process over a unix socket instead of being read
+// eagerly, so the VMM can resume before the whole memory file has been
+// transferred (post-copy restore).
+func (fc *firecracker) Restore(ctx context.Context, dir string) error {
+	memBackend := &models.MemBackend{}
+	if fc.config.EnableUffdRestore {
+		uffdSockPath := filepath.Join(dir, fcSnapshotUffdSock)
+		memFilePath := filepath.Join(dir, fcSnapshotMemFile)
+
+		if err := setupUffd(uffdSockPath, memFilePath); err != nil {
+			return fmt.Errorf("failed to set up UFFD for post-copy restore: %w", err)
+		}
+
+		backendType := "Uffd"
+		memBackend.BackendType = &backendType
+		memBackend.BackendPath = &uffdSockPath
+	} else {
+		memFilePath := filepath.Join(dir, fcSnapshotMemFile)
+		backendType := "File"
+		memBackend.BackendType = &backendType
+		memBackend.BackendPath = &memFilePath
+	}
+
+	snapshotPath := filepath.Join(dir, fcSnapshotVMState)
+	resume := true
+
+	params := operations.NewLoadSnapshotParamsWithContext(ctx).WithBody(&models.SnapshotLoadParams{
+		MemBackend:   memBackend,
+		SnapshotPath: &snapshotPath,
+		ResumeVM:     resume,
+	})
+
+	if _, err := fc.client().Operations.LoadSnapshot(params); err != nil {
+		if badReq, ok := err.(*operations.LoadSnapshotBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("LoadSnapshot failed: %w", err)
+	}
+
+	return nil
+}
+
+// setupUffd opens memFilePath and listens on uffdSockPath for a single
+// connection from the Firecracker VMM, then hands the memory file's fd off
+// over SCM_RIGHTS and returns; it does not serve guest page faults itself,
+// and it blocks until a peer connects. It takes
+// no receiver since it only touches the filesystem and the socket it sets
+// up, which keeps it unit-testable on its own.
+func setupUffd(uffdSockPath, memFilePath string) error {
+	memFile, err := os.Open(memFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open memory file %q: %w", memFilePath, err)
+	}
+	defer memFile.Close()
+
+	l, err := net.Listen("unix", uffdSockPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on UFFD socket %q: %w", uffdSockPath, err)
+	}
+	defer l.Close()
+
+	conn, err := l.Accept()
+	if err != nil {
+		return fmt.Errorf("failed to accept UFFD connection: %w", err)
+	}
+	defer conn.Close()
+
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return fmt.Errorf("UFFD connection is not a unix socket")
+	}
+
+	f, err := unixConn.File()
+	if err != nil {
+		return fmt.Errorf("failed to get UFFD socket fd: %w", err)
+	}
+	defer f.Close()
+
+	rights := unix.UnixRights(int(memFile.Fd()))
+	return unix.Sendmsg(int(f.Fd()), nil, rights, nil, 0)
+}
+
+// setEntropyDevice requests a virtio-rng entropy device from the VMM,
+// letting the guest draw on host-provided entropy instead of relying solely
+// on its own RNG. It is controlled by HypervisorConfig.EnableVirtioRNG and
+// must run before InstanceStart.
+func (fc *firecracker) setEntropyDevice(ctx context.Context) error {
+	if !fc.config.EnableVirtioRNG {
+		return nil
+	}
+
+	params := operations.NewPutEntropyDeviceParamsWithContext(ctx).WithBody(&models.EntropyDevice{})
+	if _, err := fc.client().Operations.PutEntropyDevice(params); err != nil {
+		if badReq, ok := err.(*operations.PutEntropyDeviceBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PutEntropyDevice failed: %w", err)
+	}
+
+	return nil
+}
+
+// setMetrics points the VMM's structured metrics output at
+// HypervisorConfig.FCMetricsPath, if set, so operators can collect
+// per-microVM telemetry. It must run before InstanceStart.
+func (fc *firecracker) setMetrics(ctx context.Context) error {
+	if fc.config.FCMetricsPath == "" {
+		return nil
+	}
+
+	metricsPath := fc.config.FCMetricsPath
+	params := operations.NewPutMetricsParamsWithContext(ctx).WithBody(&models.Metrics{MetricsPath: &metricsPath})
+	if _, err := fc.client().Operations.PutMetrics(params); err != nil {
+		if badReq, ok := err.(*operations.PutMetricsBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PutMetrics failed: %w", err)
+	}
+
+	return nil
+}
+
+// setLogger configures the VMM's structured logger, setting its level to
+// HypervisorConfig.FCLogLevel when set. It must run before InstanceStart.
+func (fc *firecracker) setLogger(ctx context.Context) error {
+	logPath := filepath.Join(fc.state.VMStorePath, "firecracker.log")
+	logger := &models.Logger{
+		LogPath:       &logPath,
+		Level:         fc.config.FCLogLevel,
+		ShowLevel:     true,
+		ShowLogOrigin: true,
+	}
+
+	params := operations.NewPutLoggerParamsWithContext(ctx).WithBody(logger)
+	if _, err := fc.client().Operations.PutLogger(params); err != nil {
+		if badReq, ok := err.(*operations.PutLoggerBadRequest); ok {
+			return fcerrors.Classify(badReq.Payload)
+		}
+		return fmt.Errorf("PutLogger failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+// TestSetupUffdSendsMemFileFD dials the socket setupUffd listens on and
+// verifies it receives the memory file's fd over SCM_RIGHTS, and that the fd
+// actually refers to the same file (not just some fd).
+func TestSetupUffdSendsMemFileFD(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	memFilePath := filepath.Join(dir, "mem-file")
+	const want = "hello from the guest memory file"
+	assert.NoError(os.WriteFile(memFilePath, []byte(want), 0o600))
+
+	uffdSockPath := filepath.Join(dir, "uffd.sock")
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- setupUffd(uffdSockPath, memFilePath)
+	}()
+
+	var conn net.Conn
+	for i := 0; i < 100; i++ {
+		var dialErr error
+		conn, dialErr = net.Dial("unix", uffdSockPath)
+		if dialErr == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !assert.NotNil(conn, "failed to dial UFFD socket") {
+		return
+	}
+	defer conn.Close()
+
+	unixConn, ok := conn.(*net.UnixConn)
+	if !assert.True(ok) {
+		return
+	}
+
+	raw, err := unixConn.SyscallConn()
+	assert.NoError(err)
+
+	buf := make([]byte, 1)
+	oob := make([]byte, unix.CmsgSpace(4))
+	var oobn int
+	var recvErr error
+	assert.NoError(raw.Read(func(fd uintptr) bool {
+		_, oobn, _, _, recvErr = unix.Recvmsg(int(fd), buf, oob, 0)
+		return recvErr != unix.EAGAIN
+	}))
+	assert.NoError(recvErr)
+	assert.NoError(<-errCh)
+
+	scms, err := unix.ParseSocketControlMessage(oob[:oobn])
+	assert.NoError(err)
+	if !assert.Len(scms, 1) {
+		return
+	}
+
+	fds, err := unix.ParseUnixRights(&scms[0])
+	assert.NoError(err)
+	if !assert.Len(fds, 1) {
+		return
+	}
+	defer unix.Close(fds[0])
+
+	received := os.NewFile(uintptr(fds[0]), "received-mem-file")
+	defer received.Close()
+
+	got := make([]byte, len(want))
+	_, err = received.ReadAt(got, 0)
+	assert.NoError(err)
+	assert.Equal(want, string(got))
+}
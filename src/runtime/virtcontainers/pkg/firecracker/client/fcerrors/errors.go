@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+// Package fcerrors classifies the opaque *models.Error payloads returned by
+// the generated Firecracker client into sentinel errors that callers can
+// check with errors.Is, instead of string-matching FaultMessage themselves.
+package fcerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/firecracker/client/models"
+)
+
+var (
+	// ErrDriveBusy is returned when a drive operation targets a drive_id
+	// that is already attached and cannot be reconfigured in its current
+	// state.
+	ErrDriveBusy = errors.New("drive is busy")
+
+	// ErrMMDSNotConfigured is returned when an MMDS operation is attempted
+	// before the MMDS data store has been configured.
+	ErrMMDSNotConfigured = errors.New("MMDS is not configured")
+
+	// ErrInvalidState is returned when an operation is rejected because
+	// the microVM is not in a state that allows it (for example, trying
+	// to attach a device after InstanceStart).
+	ErrInvalidState = errors.New("operation not allowed in the current microVM state")
+
+	// ErrResourceExhausted is returned when the VMM refuses a request
+	// because a resource limit (vCPUs, memory, rate limiter budget) would
+	// be exceeded.
+	ErrResourceExhausted = errors.New("resource exhausted")
+)
+
+// classifiers maps a lowercase substring of FaultMessage to the sentinel
+// error it indicates. Order doesn't matter: substrings are chosen to be
+// mutually exclusive based on the fault strings Firecracker is known to
+// return.
+var classifiers = []struct {
+	substr string
+	err    error
+}{
+	{"already attached", ErrDriveBusy},
+	{"already exists", ErrDriveBusy},
+	{"mmds", ErrMMDSNotConfigured},
+	{"microvm is running", ErrInvalidState},
+	{"operation not supported", ErrInvalidState},
+	{"cannot be updated after microvm booted", ErrInvalidState},
+	{"exceeds the available", ErrResourceExhausted},
+	{"limit exceeded", ErrResourceExhausted},
+}
+
+// Classify inspects payload.FaultMessage and returns the matching sentinel
+// error. If no known fault string matches, it returns an error wrapping the
+// raw fault message so the caller still gets a useful message, just not one
+// they can match against with errors.Is.
+func Classify(payload *models.Error) error {
+	if payload == nil {
+		return errors.New("firecracker: empty error payload")
+	}
+
+	msg := strings.ToLower(payload.FaultMessage)
+	for _, c := range classifiers {
+		if strings.Contains(msg, c.substr) {
+			return fmt.Errorf("%w: %s", c.err, payload.FaultMessage)
+		}
+	}
+
+	return fmt.Errorf("firecracker: %s", payload.FaultMessage)
+}
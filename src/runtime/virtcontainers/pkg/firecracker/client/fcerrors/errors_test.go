@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package fcerrors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/firecracker/client/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		fault string
+		want  error
+	}{
+		{"The drive is already attached to the VM", ErrDriveBusy},
+		{"Drive ID already exists", ErrDriveBusy},
+		{"MMDS is not configured", ErrMMDSNotConfigured},
+		{"Drive cannot be updated after microvm booted", ErrInvalidState},
+		{"The request exceeds the available memory", ErrResourceExhausted},
+		{"rate limiter budget limit exceeded", ErrResourceExhausted},
+	}
+
+	for _, tc := range tests {
+		err := Classify(&models.Error{FaultMessage: tc.fault})
+		assert.True(errors.Is(err, tc.want), "fault %q: expected %v, got %v", tc.fault, tc.want, err)
+	}
+}
+
+func TestClassifyUnknownFault(t *testing.T) {
+	assert := assert.New(t)
+
+	err := Classify(&models.Error{FaultMessage: "something unexpected happened"})
+	assert.Error(err)
+	assert.False(errors.Is(err, ErrDriveBusy))
+}
+
+func TestClassifyNilPayload(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Error(Classify(nil))
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package fcerrors
+
+import (
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// idempotentMethods is the set of HTTP methods RetryTransport will retry.
+// POST is deliberately excluded: Firecracker has no POST endpoints that are
+// safe to replay blindly.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:   true,
+	http.MethodPut:   true,
+	http.MethodPatch: true,
+}
+
+// RetryTransport wraps an http.RoundTripper and retries idempotent requests
+// with exponential backoff and jitter on 5xx responses and on
+// connection-refused errors, which are expected for a short window while the
+// Firecracker VMM process is still coming up.
+type RetryTransport struct {
+	// Next is the RoundTripper the retried request is ultimately sent
+	// through. It defaults to http.DefaultTransport if nil.
+	Next http.RoundTripper
+
+	// MaxRetries caps the number of retry attempts. It defaults to 3.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry; it doubles on each
+	// subsequent attempt. A nil BaseDelay defaults to 100ms; to configure a
+	// genuinely zero delay (e.g. in tests), point it at a zero-value
+	// time.Duration rather than leaving it unset.
+	BaseDelay *time.Duration
+}
+
+func (t *RetryTransport) next() http.RoundTripper {
+	if t.Next != nil {
+		return t.Next
+	}
+	return http.DefaultTransport
+}
+
+func (t *RetryTransport) maxRetries() int {
+	if t.MaxRetries > 0 {
+		return t.MaxRetries
+	}
+	return 3
+}
+
+func (t *RetryTransport) baseDelay() time.Duration {
+	if t.BaseDelay != nil {
+		return *t.BaseDelay
+	}
+	return 100 * time.Millisecond
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !idempotentMethods[req.Method] {
+		return t.next().RoundTrip(req)
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq, err = resetRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = t.next().RoundTrip(attemptReq)
+		if !t.shouldRetry(resp, err) || attempt >= t.maxRetries() {
+			return resp, err
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		time.Sleep(t.backoff(attempt))
+	}
+}
+
+// resetRequestBody returns a copy of req with a fresh, unread body,
+// since http.Transport.RoundTrip consumes and closes req.Body on send and a
+// retried request can't reuse it as-is. It requires req.GetBody, which
+// net/http and go-openapi/runtime both populate for requests built from an
+// in-memory body.
+func resetRequestBody(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+func (t *RetryTransport) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		// The VMM's unix socket may exist but not be accepting yet for
+		// a short window after the Firecracker process is forked. A
+		// socket path that does not exist yet is not retried.
+		return strings.Contains(err.Error(), "connection refused")
+	}
+	return resp != nil && resp.StatusCode >= 500
+}
+
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	delay := t.baseDelay() << attempt
+	half := int64(delay) / 2
+	if half <= 0 {
+		return delay
+	}
+	jitter := time.Duration(rand.Int63n(half))
+	return delay/2 + jitter
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package fcerrors
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// zeroDelay points at a zero-value time.Duration, for tests that want
+// instant retries rather than RetryTransport's 100ms default.
+var zeroDelay = new(time.Duration)
+
+// countingTransport fails the first failN requests with a 500, recording the
+// body of each request it sees, then succeeds.
+type countingTransport struct {
+	failN  int
+	seen   int
+	bodies []string
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.bodies = append(c.bodies, string(body))
+
+	c.seen++
+	if c.seen <= c.failN {
+		return &http.Response{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}
+	return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestRetryTransportResendsBodyOnRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	next := &countingTransport{failN: 2}
+	rt := &RetryTransport{Next: next, MaxRetries: 3, BaseDelay: zeroDelay}
+
+	req := httptest.NewRequest(http.MethodPut, "http://firecracker/drives/1", strings.NewReader(`{"drive_id":"1"}`))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(`{"drive_id":"1"}`)), nil
+	}
+
+	resp, err := rt.RoundTrip(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusNoContent, resp.StatusCode)
+	assert.Equal(3, next.seen)
+
+	for i, body := range next.bodies {
+		assert.Equal(`{"drive_id":"1"}`, body, "attempt %d sent a stale or empty body", i)
+	}
+}
+
+func TestRetryTransportSkipsNonIdempotentMethods(t *testing.T) {
+	assert := assert.New(t)
+
+	next := &countingTransport{failN: 2}
+	rt := &RetryTransport{Next: next, MaxRetries: 3, BaseDelay: zeroDelay}
+
+	req := httptest.NewRequest(http.MethodPost, "http://firecracker/actions", strings.NewReader(`{}`))
+
+	resp, _ := rt.RoundTrip(req)
+	assert.Equal(http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(1, next.seen)
+}
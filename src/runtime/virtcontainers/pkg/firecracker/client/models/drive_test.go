@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriveMarshalOmitsUnsetIoEngineAndSocket(t *testing.T) {
+	assert := assert.New(t)
+
+	driveID := "drive-0"
+	readOnly := false
+	rootDevice := true
+	pathOnHost := "/path/to/image"
+
+	d := Drive{
+		DriveID:      &driveID,
+		IsReadOnly:   &readOnly,
+		IsRootDevice: &rootDevice,
+		PathOnHost:   &pathOnHost,
+	}
+
+	data, err := json.Marshal(&d)
+	assert.NoError(err)
+	assert.NotContains(string(data), "io_engine")
+	assert.NotContains(string(data), "socket")
+}
+
+func TestDriveMarshalIncludesIoEngineAndSocketWhenSet(t *testing.T) {
+	assert := assert.New(t)
+
+	driveID := "drive-0"
+	readOnly := false
+	rootDevice := true
+
+	d := Drive{
+		DriveID:      &driveID,
+		IsReadOnly:   &readOnly,
+		IsRootDevice: &rootDevice,
+		IoEngine:     "Async",
+		Socket:       "/var/run/vhost-user-blk.sock",
+	}
+
+	data, err := json.Marshal(&d)
+	assert.NoError(err)
+	assert.Contains(string(data), `"io_engine":"Async"`)
+	assert.Contains(string(data), `"socket":"/var/run/vhost-user-blk.sock"`)
+}